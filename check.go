@@ -0,0 +1,274 @@
+package runt
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// Gen draws a single value of type T from rng. Generators compose: a
+// Gen for a struct typically calls Draw or the Rand methods for each
+// field.
+type Gen[T any] func(rng *Rand) T
+
+// Draw runs gen against rng. It exists as a package-level function,
+// rather than a method on Rand, because Go methods cannot carry their
+// own type parameters.
+func Draw[T any](rng *Rand, gen Gen[T]) T {
+	return gen(rng)
+}
+
+// Slice draws a slice of n values using gen.
+func Slice[T any](rng *Rand, n int, gen Gen[T]) []T {
+	out := make([]T, n)
+	for i := range out {
+		out[i] = gen(rng)
+	}
+	return out
+}
+
+// drawEntry records a single bounded draw made on a Rand, so that Check
+// can later replay a shrunk variant of the same sequence of draws.
+type drawEntry struct {
+	min, max int64
+	value    int64
+}
+
+// Rand is a deterministic source of random values for use with Check.
+// It wraps math/rand and is safe to thread through a T like any other
+// value available to a test.
+type Rand struct {
+	rng *rand.Rand
+
+	// record accumulates every bounded draw made during the current
+	// run, live or replayed, so Check can extract it as a shrink
+	// candidate.
+	record []drawEntry
+
+	// replay, when non-nil, supplies prior draw values in order
+	// instead of generating fresh ones, used while shrinking. Once
+	// pos reaches len(replay), draws fall back to generating fresh
+	// values from rng.
+	replay []drawEntry
+	pos    int
+}
+
+func newRand(seed int64) *Rand {
+	return &Rand{rng: rand.New(rand.NewSource(seed))}
+}
+
+func (r *Rand) intRange64(min, max int64) int64 {
+	if max < min {
+		max = min
+	}
+
+	if r.replay != nil && r.pos < len(r.replay) {
+		v := r.replay[r.pos].value
+		r.pos++
+		if v < min {
+			v = min
+		}
+		if v > max {
+			v = max
+		}
+		r.record = append(r.record, drawEntry{min, max, v})
+		return v
+	}
+
+	v := min
+	if max > min {
+		v = min + r.rng.Int63n(max-min+1)
+	}
+	r.record = append(r.record, drawEntry{min, max, v})
+	return v
+}
+
+// Int draws an int within the range of a 32-bit signed integer. The
+// range is bounded (rather than the full platform int) so that
+// shrinking candidates (zero, min, half) stay well clear of overflow.
+func (r *Rand) Int() int {
+	return int(r.intRange64(math.MinInt32, math.MaxInt32))
+}
+
+// IntRange draws an int in [min, max], inclusive.
+func (r *Rand) IntRange(min, max int) int {
+	return int(r.intRange64(int64(min), int64(max)))
+}
+
+// Bool draws a random bool.
+func (r *Rand) Bool() bool {
+	return r.intRange64(0, 1) == 1
+}
+
+// String draws a random string of length 0-16 using StringN.
+func (r *Rand) String() string {
+	return r.StringN(r.IntRange(0, 16))
+}
+
+// StringN draws a random alphanumeric string of exactly n characters.
+func (r *Rand) StringN(n int) string {
+	const alphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = alphabet[r.IntRange(0, len(alphabet)-1)]
+	}
+	return string(b)
+}
+
+// CheckConfig configures Check. When Check is called with no
+// CheckConfig at all, it runs 100 checks with shrinking enabled and an
+// automatically chosen seed. A CheckConfig that is passed is used as
+// given (apart from the Checks default below), so shrinking is off
+// unless Shrink is set.
+type CheckConfig struct {
+	// Checks is how many times prop is run. Defaults to 100 if <= 0.
+	Checks int
+	// Seed seeds the deterministic PRNG. If zero, the RUNT_SEED
+	// environment variable is used if set, otherwise a seed derived
+	// from the current time.
+	Seed int64
+	// Shrink enables shrinking of a failing case to a smaller
+	// reproduction once prop fails.
+	Shrink bool
+}
+
+func defaultCheckConfig() CheckConfig {
+	return CheckConfig{Checks: 100, Shrink: true}
+}
+
+func resolveSeed(seed int64) int64 {
+	if seed != 0 {
+		return seed
+	}
+	if s := os.Getenv("RUNT_SEED"); s != "" {
+		if parsed, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return parsed
+		}
+	}
+	return time.Now().UnixNano()
+}
+
+// Check runs prop repeatedly with a deterministic Rand, looking for a
+// failing input. prop reports failure the same way any runt or
+// testing.TB test does: Error, Fatal, or a panic; a prop that calls
+// Skip counts as passing that check. On failure, Check attempts to
+// shrink the failing input (unless disabled via CheckConfig.Shrink =
+// false) and reports, via tb.Errorf, the smallest failing draws it
+// found along with a CheckConfig whose seed reproduces the original
+// failing check.
+func Check(tb testing.TB, prop func(tb testing.TB, rng *Rand), cfg ...CheckConfig) {
+	tb.Helper()
+
+	c := defaultCheckConfig()
+	if len(cfg) > 0 {
+		c = cfg[0]
+	}
+	if c.Checks <= 0 {
+		c.Checks = 100
+	}
+	seed := resolveSeed(c.Seed)
+
+	for i := 0; i < c.Checks; i++ {
+		rng := newRand(seed + int64(i))
+
+		failed, logs := runProp(tb, prop, rng)
+		if !failed {
+			continue
+		}
+
+		draws := rng.record
+		if c.Shrink {
+			draws = shrinkDraws(tb, prop, draws)
+		}
+
+		tb.Errorf(
+			"runt.Check: property failed after %d check(s) (seed=%d)\nreproduce with: CheckConfig{Seed: %d, Checks: 1, Shrink: false}\ndraws: %s\n%s",
+			i+1, seed, seed+int64(i), formatDraws(draws), logs,
+		)
+		return
+	}
+}
+
+// runProp runs prop against a fresh subtest, so that an Error, Fatal,
+// or panic inside prop is captured the same way any other runt test is,
+// without killing the caller's tb.
+func runProp(tb testing.TB, prop func(testing.TB, *Rand), rng *Rand) (failed bool, logs string) {
+	ctx := context.Background()
+	if t, ok := tb.(*T); ok {
+		ctx = t.Context()
+	}
+
+	sub := New(ctx, "check")
+	runTB(sub, func(inner testing.TB) {
+		prop(inner, rng)
+	})
+	return sub.Failed(), sub.Logs()
+}
+
+// shrinkDraws repeatedly tries to replace each recorded draw with a
+// smaller biased candidate (zero, the draw's min, or halfway to its
+// min), keeping any replacement that still reproduces the failure,
+// until a full pass over every draw makes no further progress.
+func shrinkDraws(tb testing.TB, prop func(testing.TB, *Rand), draws []drawEntry) []drawEntry {
+	best := append([]drawEntry(nil), draws...)
+
+	for {
+		improved := false
+
+		for i, entry := range best {
+			for _, candidate := range shrinkCandidates(entry) {
+				if candidate >= entry.value {
+					continue
+				}
+
+				trial := append([]drawEntry(nil), best...)
+				trial[i].value = candidate
+
+				rng := &Rand{rng: rand.New(rand.NewSource(0)), replay: trial}
+				failed, _ := runProp(tb, prop, rng)
+				if !failed {
+					continue
+				}
+
+				best = rng.record
+				improved = true
+				break
+			}
+			if improved {
+				break
+			}
+		}
+
+		if !improved {
+			return best
+		}
+	}
+}
+
+// shrinkCandidates returns the biased replacement values to try for a
+// single draw: zero (clamped into range), the draw's minimum, and
+// halfway between its minimum and its current value.
+func shrinkCandidates(e drawEntry) []int64 {
+	zero := int64(0)
+	if zero < e.min {
+		zero = e.min
+	}
+	if zero > e.max {
+		zero = e.max
+	}
+	half := e.min + (e.value-e.min)/2
+	return []int64{zero, e.min, half}
+}
+
+func formatDraws(draws []drawEntry) string {
+	values := make([]int64, len(draws))
+	for i, d := range draws {
+		values[i] = d.value
+	}
+	return fmt.Sprintf("%v", values)
+}
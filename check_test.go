@@ -0,0 +1,152 @@
+package runt
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestCheck_PassingPropertyReportsNoError(t *testing.T) {
+	ctx := context.Background()
+
+	err := Run(ctx, func(tb testing.TB) {
+		Check(tb, func(tb testing.TB, rng *Rand) {
+			n := rng.IntRange(0, 100)
+			if n < 0 || n > 100 {
+				tb.Fatalf("out of range: %d", n)
+			}
+		})
+	})
+
+	if err != nil {
+		t.Errorf("Expected nil error for a property that always holds, got: %v", err)
+	}
+}
+
+func TestCheck_FailureAttributedToCallerNotCheckItself(t *testing.T) {
+	ctx := context.Background()
+
+	err := Run(ctx, func(tb testing.TB) {
+		Check(tb, func(tb testing.TB, rng *Rand) {
+			n := rng.IntRange(0, 100)
+			if n > 5 {
+				tb.Fatalf("n too large: %d", n)
+			}
+		}, CheckConfig{Checks: 200, Seed: 1})
+	})
+
+	if err == nil {
+		t.Fatal("Expected error for a property that can fail, got nil")
+	}
+	if strings.Contains(err.Error(), "check.go:") {
+		t.Errorf("Expected Check's own frame (via its tb.Helper() call) not to be reported, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "check_test.go:") {
+		t.Errorf("Expected the failure to be attributed to this file, got: %v", err)
+	}
+}
+
+func TestCheck_FailingPropertyIsReported(t *testing.T) {
+	ctx := context.Background()
+
+	err := Run(ctx, func(tb testing.TB) {
+		Check(tb, func(tb testing.TB, rng *Rand) {
+			n := rng.IntRange(0, 100)
+			if n > 5 {
+				tb.Fatalf("n too large: %d", n)
+			}
+		}, CheckConfig{Checks: 200, Seed: 1})
+	})
+
+	if err == nil {
+		t.Fatal("Expected error for a property that can fail, got nil")
+	}
+	if !strings.Contains(err.Error(), "runt.Check: property failed") {
+		t.Errorf("Expected error to describe the Check failure, got: %v", err)
+	}
+}
+
+func propOverTen(tb testing.TB, rng *Rand) {
+	n := rng.IntRange(0, 1000)
+	if n > 10 {
+		tb.Fatalf("n too large: %d", n)
+	}
+}
+
+func TestCheck_ShrinksTowardSmallerFailure(t *testing.T) {
+	ctx := context.Background()
+
+	unshrunk := Run(ctx, func(tb testing.TB) {
+		Check(tb, propOverTen, CheckConfig{Checks: 50, Seed: 42, Shrink: false})
+	})
+	shrunk := Run(ctx, func(tb testing.TB) {
+		Check(tb, propOverTen, CheckConfig{Checks: 50, Seed: 42, Shrink: true})
+	})
+
+	if unshrunk == nil || shrunk == nil {
+		t.Fatal("Expected both runs to report a failure")
+	}
+
+	extractDraw := func(err error) int {
+		msg := err.Error()
+		start := strings.Index(msg, "draws: [")
+		if start < 0 {
+			t.Fatalf("Expected a draws: [...] section, got: %s", msg)
+		}
+		msg = msg[start+len("draws: ["):]
+		end := strings.IndexByte(msg, ']')
+		n, parseErr := strconv.Atoi(msg[:end])
+		if parseErr != nil {
+			t.Fatalf("Failed to parse draw value from %q: %v", msg[:end], parseErr)
+		}
+		return n
+	}
+
+	unshrunkN := extractDraw(unshrunk)
+	shrunkN := extractDraw(shrunk)
+
+	if shrunkN <= 10 {
+		t.Errorf("Expected the shrunk draw to still fail (>10), got: %d", shrunkN)
+	}
+	if shrunkN > unshrunkN {
+		t.Errorf("Expected shrinking to not grow the failing draw: unshrunk=%d shrunk=%d", unshrunkN, shrunkN)
+	}
+}
+
+func TestCheck_DeterministicWithSameSeed(t *testing.T) {
+	ctx := context.Background()
+
+	var first, second string
+	Run(ctx, func(tb testing.TB) {
+		Check(tb, func(tb testing.TB, rng *Rand) {
+			first += rng.String()
+		}, CheckConfig{Checks: 5, Seed: 7})
+	})
+	Run(ctx, func(tb testing.TB) {
+		Check(tb, func(tb testing.TB, rng *Rand) {
+			second += rng.String()
+		}, CheckConfig{Checks: 5, Seed: 7})
+	})
+
+	if first != second {
+		t.Errorf("Expected the same seed to produce the same draws, got %q and %q", first, second)
+	}
+}
+
+func TestDraw_UsesGivenGenerator(t *testing.T) {
+	rng := newRand(1)
+	gen := Gen[int](func(r *Rand) int { return r.IntRange(5, 5) })
+	if got := Draw(rng, gen); got != 5 {
+		t.Errorf("Expected Draw to return 5, got: %d", got)
+	}
+}
+
+func TestSlice_DrawsRequestedLength(t *testing.T) {
+	rng := newRand(1)
+	gen := Gen[int](func(r *Rand) int { return r.IntRange(0, 0) })
+	out := Slice(rng, 4, gen)
+	if len(out) != 4 {
+		t.Errorf("Expected a slice of length 4, got: %d", len(out))
+	}
+}
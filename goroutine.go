@@ -0,0 +1,46 @@
+package runt
+
+import (
+	"context"
+	"runtime/debug"
+)
+
+// Go spawns fn in a new goroutine, passing it the test's context. Run
+// (and (*T).Run, for subtests) automatically waits for every goroutine
+// started this way before returning; call Wait to join them earlier.
+//
+// A non-nil error returned by fn, or a panic inside it, is recorded as
+// a test failure (logged with name). Calling Fatal/FailNow or
+// Skip/SkipNow from within fn is not supported, since nothing recovers
+// a panic on a goroutine Run isn't watching directly; Go detects this
+// and records it as a failure with a diagnostic instead of crashing
+// the process, but fn should prefer returning an error.
+func (e *T) Go(name string, fn func(ctx context.Context) error) {
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer func() {
+			x := recover()
+			switch x {
+			case nil:
+			case testFailed{}:
+				e.Errorf("goroutine %q called Fatal/FailNow, which Go does not support; return an error instead", name)
+			case testSkipped{}:
+				e.Errorf("goroutine %q called Skip/SkipNow, which Go does not support; return an error instead", name)
+			default:
+				e.Errorf("goroutine %q panicked: %v\n%s", name, x, debug.Stack())
+			}
+		}()
+
+		if err := fn(e.ctx); err != nil {
+			e.Errorf("goroutine %q failed: %v", name, err)
+		}
+	}()
+}
+
+// Wait blocks until every goroutine started via Go has finished. Run
+// (and (*T).Run) call this automatically before returning, so explicit
+// calls are only needed to join background work earlier.
+func (e *T) Wait() {
+	e.wg.Wait()
+}
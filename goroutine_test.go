@@ -0,0 +1,134 @@
+package runt
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRun_GoWaitsForBackgroundWork(t *testing.T) {
+	ctx := context.Background()
+
+	var ran int32
+
+	err := Run(ctx, func(tb testing.TB) {
+		runtT := tb.(*T)
+		runtT.Go("worker", func(ctx context.Context) error {
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&ran, 1)
+			return nil
+		})
+	})
+
+	if err != nil {
+		t.Errorf("Expected nil error, got: %v", err)
+	}
+	if atomic.LoadInt32(&ran) != 1 {
+		t.Errorf("Expected Run to wait for the background goroutine to finish, ran: %d", ran)
+	}
+}
+
+func TestRun_GoErrorFailsTest(t *testing.T) {
+	ctx := context.Background()
+
+	err := Run(ctx, func(tb testing.TB) {
+		runtT := tb.(*T)
+		runtT.Go("worker", func(ctx context.Context) error {
+			return errors.New("boom")
+		})
+	})
+
+	if err == nil {
+		t.Fatal("Expected error when a background goroutine returns an error, got nil")
+	}
+	if !strings.Contains(err.Error(), `goroutine "worker" failed: boom`) {
+		t.Errorf("Expected error to name the goroutine and its error, got: %v", err)
+	}
+}
+
+func TestRun_GoPanicFailsTest(t *testing.T) {
+	ctx := context.Background()
+
+	err := Run(ctx, func(tb testing.TB) {
+		runtT := tb.(*T)
+		runtT.Go("worker", func(ctx context.Context) error {
+			panic("kaboom")
+		})
+	})
+
+	if err == nil {
+		t.Fatal("Expected error when a background goroutine panics, got nil")
+	}
+	if !strings.Contains(err.Error(), `goroutine "worker" panicked: kaboom`) {
+		t.Errorf("Expected error to describe the goroutine panic, got: %v", err)
+	}
+}
+
+func TestRun_GoFatalFromGoroutineIsDiagnosed(t *testing.T) {
+	ctx := context.Background()
+
+	err := Run(ctx, func(tb testing.TB) {
+		runtT := tb.(*T)
+		runtT.Go("worker", func(ctx context.Context) error {
+			runtT.Fatal("should not call Fatal from a goroutine")
+			return nil
+		})
+	})
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "does not support") {
+		t.Errorf("Expected a diagnostic about Fatal not being supported from goroutines, got: %v", err)
+	}
+}
+
+func TestRun_ContextCancelledOnFailure(t *testing.T) {
+	ctx := context.Background()
+
+	cancelled := make(chan struct{})
+
+	err := Run(ctx, func(tb testing.TB) {
+		runtT := tb.(*T)
+		runtT.Go("watcher", func(ctx context.Context) error {
+			<-ctx.Done()
+			close(cancelled)
+			return nil
+		})
+		runtT.Error("fail now")
+	})
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Error("Expected the test's context to be cancelled promptly on failure")
+	}
+}
+
+func TestRun_WaitJoinsEarly(t *testing.T) {
+	ctx := context.Background()
+
+	var ran int32
+
+	err := Run(ctx, func(tb testing.TB) {
+		runtT := tb.(*T)
+		runtT.Go("worker", func(ctx context.Context) error {
+			atomic.AddInt32(&ran, 1)
+			return nil
+		})
+		runtT.Wait()
+		if atomic.LoadInt32(&ran) != 1 {
+			tb.Error("Expected Wait to join the goroutine before returning")
+		}
+	})
+
+	if err != nil {
+		t.Errorf("Expected nil error, got: %v", err)
+	}
+}
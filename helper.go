@@ -0,0 +1,112 @@
+package runt
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sync"
+)
+
+// logFuncs holds the qualified names of runt's own logging entry
+// points (Log, Logf, Error, ...), so that callerPrefix can skip over
+// them and attribute a log line to the test code that actually called
+// one of them, rather than to runt's own internals. It's built lazily,
+// on first use, since building it eagerly as a package-level variable
+// would create an initialization cycle (Log and friends call
+// callerPrefix, which reads logFuncs).
+var (
+	logFuncs     map[string]struct{}
+	logFuncsOnce sync.Once
+)
+
+func buildLogFuncs() {
+	fns := []any{
+		(*T).Log,
+		(*T).Logf,
+		(*T).Error,
+		(*T).Errorf,
+		(*T).Fatal,
+		(*T).Fatalf,
+		(*T).Skip,
+		(*T).Skipf,
+	}
+
+	logFuncs = make(map[string]struct{}, len(fns))
+	for _, fn := range fns {
+		if f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()); f != nil {
+			logFuncs[f.Name()] = struct{}{}
+		}
+	}
+}
+
+// Helper marks the calling function as a test helper. When Log, Logf,
+// Error, or similar walk the call stack to attribute a log line to its
+// caller, every frame belonging to the marked function is skipped,
+// exactly like testing.T.Helper. The PC recorded here is only ever
+// used to recover the helper's function name (via runtime.FuncForPC),
+// since the call to Helper and the later call to Log/Error/Fatal are
+// almost never on the same line.
+func (e *T) Helper() {
+	var pcs [1]uintptr
+	if runtime.Callers(2, pcs[:]) < 1 {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.helperPCs == nil {
+		e.helperPCs = make(map[uintptr]struct{})
+	}
+	e.helperPCs[pcs[0]] = struct{}{}
+}
+
+// helperNames resolves the recorded helper call-site PCs to their
+// containing function names, so that callerPrefix can skip every frame
+// of a helper function regardless of which line within it is executing.
+func (e *T) helperNames() map[string]struct{} {
+	e.mu.Lock()
+	pcs := e.helperPCs
+	e.mu.Unlock()
+
+	if len(pcs) == 0 {
+		return nil
+	}
+
+	names := make(map[string]struct{}, len(pcs))
+	for pc := range pcs {
+		if f := runtime.FuncForPC(pc); f != nil {
+			names[f.Name()] = struct{}{}
+		}
+	}
+	return names
+}
+
+// callerPrefix walks the call stack looking for the first frame that
+// is neither one of runt's own logging entry points nor inside a
+// function marked as a helper via Helper, and returns a "file:line: "
+// prefix for it, or "" if none is found.
+func (e *T) callerPrefix() string {
+	logFuncsOnce.Do(buildLogFuncs)
+	helpers := e.helperNames()
+
+	var pcs [64]uintptr
+	n := runtime.Callers(2, pcs[:])
+	if n == 0 {
+		return ""
+	}
+
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+
+		_, isHelper := helpers[frame.Function]
+		_, isLogFunc := logFuncs[frame.Function]
+		if !isHelper && !isLogFunc {
+			return fmt.Sprintf("%s:%d: ", filepath.Base(frame.File), frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
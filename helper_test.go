@@ -0,0 +1,64 @@
+package runt
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestRun_LogPrefixesCallerFileLine(t *testing.T) {
+	ctx := context.Background()
+
+	err := Run(ctx, func(tb testing.TB) {
+		tb.Error("This test should fail") // direct call, no Helper involved
+	})
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "helper_test.go:") {
+		t.Errorf("Expected the log line to be prefixed with this file's name and line, got: %v", err)
+	}
+}
+
+func TestRun_HelperFileLineNotReported(t *testing.T) {
+	ctx := context.Background()
+
+	err := Run(ctx, func(tb testing.TB) {
+		assertTrue(tb, false)
+	})
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if strings.Contains(err.Error(), "helperassert_test.go") || strings.Contains(err.Error(), "assertTrue") {
+		t.Errorf("Expected the helper's own file/line not to appear in the output, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "helper_test.go:") {
+		t.Errorf("Expected the log line to be attributed to the caller of assertTrue, got: %v", err)
+	}
+}
+
+func TestRun_HelperSkippedWithinSubtest(t *testing.T) {
+	ctx := context.Background()
+
+	var subLogs string
+
+	err := Run(ctx, func(tb testing.TB) {
+		runtT := tb.(*T)
+		runtT.Run("sub", func(sub *T) {
+			assertTrue(sub, false)
+			subLogs = sub.Logs()
+		})
+	})
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if strings.Contains(subLogs, "helperassert_test.go") || strings.Contains(subLogs, "assertTrue") {
+		t.Errorf("Expected the helper's own frame not to appear even from a subtest, got: %v", subLogs)
+	}
+	if !strings.Contains(subLogs, "helper_test.go:") {
+		t.Errorf("Expected the log line to be attributed to the caller within the subtest, got: %v", subLogs)
+	}
+}
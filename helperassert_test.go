@@ -0,0 +1,14 @@
+package runt
+
+import "testing"
+
+// assertTrue lives in its own file so that helper_test.go's assertions
+// about file:line attribution can actually distinguish "attributed to
+// the caller" from "attributed to the helper's own body" (they'd be
+// indistinguishable if both lived in the same file).
+func assertTrue(tb testing.TB, cond bool) {
+	tb.Helper()
+	if !cond {
+		tb.Error("expected condition to be true")
+	}
+}
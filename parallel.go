@@ -0,0 +1,105 @@
+package runt
+
+import "fmt"
+
+// SetParallelism caps how many of this test's parallel subtests
+// (registered via a child calling (*T).Parallel) may run concurrently.
+// Zero or negative means unlimited. It must be called before the
+// subtests are started; changing it mid-run only affects subtests
+// started afterward.
+func (e *T) SetParallelism(n int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.parallelism = n
+	e.sem = nil
+}
+
+// semaphore lazily builds the concurrency limiter for this test's
+// parallel children, sized by SetParallelism. It returns nil when no
+// limit has been configured.
+func (e *T) semaphore() chan struct{} {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.parallelism <= 0 {
+		return nil
+	}
+	if e.sem == nil {
+		e.sem = make(chan struct{}, e.parallelism)
+	}
+	return e.sem
+}
+
+// Parallel signals that this subtest should run concurrently with its
+// siblings rather than blocking the (*T).Run call that started it. It
+// has no effect when called on a test with no parent (e.g. the
+// top-level test passed to Run). If the parent has a configured
+// parallelism limit, Parallel blocks until a concurrency slot is free.
+// Calling Parallel more than once on the same test is a misuse bug
+// (mirroring testing.T, which panics with "t.Parallel called multiple
+// times"); rather than risk deadlocking on an already-held semaphore
+// slot, a repeat call is reported as a failure and otherwise ignored.
+func (e *T) Parallel() {
+	if e.parent == nil {
+		return
+	}
+
+	e.mu.Lock()
+	alreadyCalled := e.parallelCalled
+	e.parallelCalled = true
+	e.mu.Unlock()
+
+	if alreadyCalled {
+		e.Errorf("Parallel called multiple times")
+		return
+	}
+
+	select {
+	case e.parallelSignal <- struct{}{}:
+	default:
+	}
+
+	if sem := e.parent.semaphore(); sem != nil {
+		sem <- struct{}{}
+		e.acquiredSem = sem
+	}
+}
+
+// releaseParallelSlot releases the concurrency slot acquired by
+// Parallel, if any. It is called once this test's body and cleanups
+// have finished running.
+func (e *T) releaseParallelSlot() {
+	if e.acquiredSem != nil {
+		<-e.acquiredSem
+	}
+}
+
+// waitParallel waits for every parallel subtest registered under this
+// test (via a child's Parallel call) to finish, then appends each
+// child's logs to this test's log, in the order the children actually
+// completed, under a header naming the child.
+func (e *T) waitParallel() {
+	e.mu.Lock()
+	children := e.parallelChildren
+	e.parallelChildren = nil
+	e.mu.Unlock()
+
+	if len(children) == 0 {
+		return
+	}
+
+	completed := make(chan *T, len(children))
+	for _, child := range children {
+		child := child
+		go func() {
+			<-child.finished
+			completed <- child
+		}()
+	}
+
+	for range children {
+		child := <-completed
+		e.mu.Lock()
+		fmt.Fprintf(e.logs, "--- %s ---\n%s", child.Name(), child.Logs())
+		e.mu.Unlock()
+	}
+}
@@ -0,0 +1,150 @@
+package runt
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRun_ParallelSubtestsRunConcurrently(t *testing.T) {
+	ctx := context.Background()
+
+	var running int32
+	var maxRunning int32
+	var wg sync.WaitGroup
+	wg.Add(3)
+
+	err := Run(ctx, func(tb testing.TB) {
+		runtT := tb.(*T)
+
+		for i := 0; i < 3; i++ {
+			runtT.Run("parallel", func(sub *T) {
+				sub.Parallel()
+				defer wg.Done()
+
+				n := atomic.AddInt32(&running, 1)
+				for {
+					old := atomic.LoadInt32(&maxRunning)
+					if n <= old || atomic.CompareAndSwapInt32(&maxRunning, old, n) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+			})
+		}
+	})
+
+	wg.Wait()
+
+	if err != nil {
+		t.Errorf("Expected nil error, got: %v", err)
+	}
+	if maxRunning < 2 {
+		t.Errorf("Expected at least 2 parallel subtests to overlap, max concurrent was: %d", maxRunning)
+	}
+}
+
+func TestRun_ParallelRespectsSetParallelism(t *testing.T) {
+	ctx := context.Background()
+
+	var running int32
+	var maxRunning int32
+
+	err := Run(ctx, func(tb testing.TB) {
+		runtT := tb.(*T)
+		runtT.SetParallelism(1)
+
+		for i := 0; i < 3; i++ {
+			runtT.Run("parallel", func(sub *T) {
+				sub.Parallel()
+
+				n := atomic.AddInt32(&running, 1)
+				for {
+					old := atomic.LoadInt32(&maxRunning)
+					if n <= old || atomic.CompareAndSwapInt32(&maxRunning, old, n) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+			})
+		}
+	})
+
+	if err != nil {
+		t.Errorf("Expected nil error, got: %v", err)
+	}
+	if maxRunning != 1 {
+		t.Errorf("Expected SetParallelism(1) to serialize subtests, max concurrent was: %d", maxRunning)
+	}
+}
+
+func TestRun_ParallelFailurePropagates(t *testing.T) {
+	ctx := context.Background()
+
+	err := Run(ctx, func(tb testing.TB) {
+		runtT := tb.(*T)
+
+		runtT.Run("parallel-fail", func(sub *T) {
+			sub.Parallel()
+			sub.Error("parallel subtest failed")
+		})
+	})
+
+	if err == nil {
+		t.Fatal("Expected error when a parallel subtest fails, got nil")
+	}
+}
+
+func TestRun_ParallelCalledTwiceFailsInsteadOfDeadlocking(t *testing.T) {
+	ctx := context.Background()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- Run(ctx, func(tb testing.TB) {
+			runtT := tb.(*T)
+			runtT.SetParallelism(1)
+
+			runtT.Run("parallel", func(sub *T) {
+				sub.Parallel()
+				sub.Parallel()
+			})
+		})
+	}()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("Expected error from calling Parallel twice, got nil")
+		}
+		if !strings.Contains(err.Error(), "Parallel called multiple times") {
+			t.Errorf("Expected a diagnostic about Parallel being called multiple times, got: %v", err)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Run deadlocked: calling Parallel twice should fail, not hang")
+	}
+}
+
+func TestRun_ParallelLogsAggregatedWithHeader(t *testing.T) {
+	ctx := context.Background()
+
+	err := Run(ctx, func(tb testing.TB) {
+		runtT := tb.(*T)
+
+		runtT.Run("parallel-one", func(sub *T) {
+			sub.Parallel()
+			sub.Error("boom")
+		})
+	})
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "--- parallel-one ---") || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("Expected logs to contain a header naming the subtest and its log, got: %v", err)
+	}
+}
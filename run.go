@@ -6,27 +6,30 @@ import (
 	"testing"
 )
 
+// RunOptions configures optional behavior for Run, such as the
+// concurrency limit applied to parallel subtests registered directly
+// under the top-level test via (*T).Parallel.
+type RunOptions struct {
+	// Parallelism caps how many of the top-level test's parallel
+	// subtests may run concurrently. Zero or negative means unlimited.
+	Parallelism int
+}
+
 // Run executes a test function with a new test instance.
 // It creates a new T instance with the provided context and executes the
-// provided callback function. The function handles panics gracefully and
-// returns an error if the test fails or is skipped, including the test logs.
-// Returns nil if the test passes successfully.
-func Run(ctx context.Context, cb func(testing.TB)) error {
+// provided callback function. The function handles panics gracefully,
+// waits for any parallel subtests to finish, drains any registered
+// Cleanup functions in LIFO order, and returns an error, including the
+// test logs, if the test fails. Returns nil if the test passes or is
+// skipped without failing. An optional RunOptions configures parallel
+// subtest concurrency.
+func Run(ctx context.Context, cb func(testing.TB), opts ...RunOptions) error {
 	t := New(ctx, "test")
+	if len(opts) > 0 {
+		t.SetParallelism(opts[0].Parallelism)
+	}
 
-	(func() {
-		// capture test panics, from assertions, skips, or otherwise
-		defer func() {
-			x := recover()
-			switch x {
-			case nil:
-			case testSkipped{}, testFailed{}:
-			default:
-				t.Errorf("PANIC: %v", x)
-			}
-		}()
-		cb(t)
-	})()
+	runTB(t, cb)
 
 	if t.Failed() {
 		return fmt.Errorf("test failed:\n%s", t.Logs())
@@ -34,3 +37,27 @@ func Run(ctx context.Context, cb func(testing.TB)) error {
 
 	return nil
 }
+
+// runTB executes cb against t, recovering panics, waiting for any
+// background goroutines started via (*T).Go and any parallel subtests
+// to finish, running cb's registered Cleanup functions in LIFO order,
+// and cancelling t's context, exactly as Run does. It is shared by Run
+// and Serve, which need access to t after cb returns (e.g. to inspect
+// Skipped, not just Failed).
+func runTB(t *T, cb func(testing.TB)) {
+	defer t.cancel()
+	// capture test panics, from assertions, skips, or otherwise
+	defer func() {
+		x := recover()
+		switch x {
+		case nil:
+		case testSkipped{}, testFailed{}:
+		default:
+			t.Errorf("PANIC: %v", x)
+		}
+	}()
+	defer t.runCleanups()
+	defer t.Wait()
+	defer t.waitParallel()
+	cb(t)
+}
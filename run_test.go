@@ -3,6 +3,7 @@ package runt
 import (
 	"context"
 	"errors"
+	"os"
 	"strings"
 	"testing"
 )
@@ -408,3 +409,108 @@ func TestRun_PanicThenRecover(t *testing.T) {
 		t.Errorf("Expected nil error for test with internal panic recovery, got: %v", err)
 	}
 }
+
+func TestRun_CleanupLIFOOrder(t *testing.T) {
+	ctx := context.Background()
+
+	var order []int
+
+	err := Run(ctx, func(tb testing.TB) {
+		tb.Cleanup(func() { order = append(order, 1) })
+		tb.Cleanup(func() { order = append(order, 2) })
+		tb.Cleanup(func() { order = append(order, 3) })
+	})
+
+	if err != nil {
+		t.Errorf("Expected nil error for test with cleanups, got: %v", err)
+	}
+
+	expected := []int{3, 2, 1}
+	if len(order) != len(expected) {
+		t.Fatalf("Expected %v, got: %v", expected, order)
+	}
+	for i, v := range expected {
+		if order[i] != v {
+			t.Errorf("Expected order %v, got: %v", expected, order)
+			break
+		}
+	}
+}
+
+func TestRun_CleanupRunsOnFatal(t *testing.T) {
+	ctx := context.Background()
+
+	ran := false
+
+	err := Run(ctx, func(tb testing.TB) {
+		tb.Cleanup(func() { ran = true })
+		tb.Fatal("boom")
+	})
+
+	if err == nil {
+		t.Fatal("Expected error for fatal test, got nil")
+	}
+	if !ran {
+		t.Error("Expected cleanup to run even after Fatal")
+	}
+}
+
+func TestRun_CleanupPanicRecordedAsFailure(t *testing.T) {
+	ctx := context.Background()
+
+	err := Run(ctx, func(tb testing.TB) {
+		tb.Cleanup(func() { panic("cleanup boom") })
+	})
+
+	if err == nil {
+		t.Fatal("Expected error when a cleanup panics, got nil")
+	}
+	if !strings.Contains(err.Error(), "cleanup panic: cleanup boom") {
+		t.Errorf("Expected error to mention the cleanup panic, got: %v", err)
+	}
+}
+
+func TestRun_TempDirCollisionFree(t *testing.T) {
+	ctx := context.Background()
+
+	var a, b string
+	err := Run(ctx, func(tb testing.TB) {
+		a = tb.TempDir()
+		b = tb.TempDir()
+	})
+
+	if err != nil {
+		t.Errorf("Expected nil error, got: %v", err)
+	}
+	if a == b {
+		t.Errorf("Expected distinct temp dirs, got the same path twice: %s", a)
+	}
+	if _, statErr := os.Stat(a); !os.IsNotExist(statErr) {
+		t.Errorf("Expected temp dir %s to be removed after test, stat err: %v", a, statErr)
+	}
+	if _, statErr := os.Stat(b); !os.IsNotExist(statErr) {
+		t.Errorf("Expected temp dir %s to be removed after test, stat err: %v", b, statErr)
+	}
+}
+
+func TestRun_SetenvRestoresPriorValue(t *testing.T) {
+	ctx := context.Background()
+
+	const key = "RUNT_TEST_SETENV_RESTORE"
+	os.Setenv(key, "original")
+	defer os.Unsetenv(key)
+
+	err := Run(ctx, func(tb testing.TB) {
+		tb.Setenv(key, "changed")
+		if os.Getenv(key) != "changed" {
+			tb.Error("Expected env var to be changed during test")
+		}
+	})
+
+	if err != nil {
+		t.Errorf("Expected nil error, got: %v", err)
+	}
+	if got := os.Getenv(key); got != "original" {
+		t.Errorf("Expected env var restored to 'original', got: %q", got)
+	}
+}
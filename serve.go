@@ -0,0 +1,120 @@
+package runt
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+)
+
+// NamedTest pairs a human-readable name with a test function, for use
+// with Serve.
+type NamedTest struct {
+	Name string
+	Fn   func(testing.TB)
+}
+
+// TestStats holds the cumulative results of repeatedly running a single
+// NamedTest under Serve.
+type TestStats struct {
+	Runs         int
+	Successes    int
+	Failures     int
+	Skips        int
+	LastDuration time.Duration
+	// Healthy reflects whether the most recent run that was not skipped
+	// passed; a skipped run leaves it unchanged.
+	Healthy bool
+}
+
+// MetricsSink receives updated TestStats after every run of a test
+// under Serve. Implementations can adapt this into a prometheus.Collector,
+// statsd client, or any other metrics backend, without runt taking a
+// hard dependency on one.
+type MetricsSink interface {
+	Report(name string, stats TestStats)
+}
+
+// ServeOptions configures Serve.
+type ServeOptions struct {
+	// Logger receives a line for every test failure. Defaults to
+	// slog.Default() if nil.
+	Logger *slog.Logger
+	// Sink, if set, is notified with updated TestStats after every run.
+	Sink MetricsSink
+}
+
+// Serve repeatedly runs each of tests on the given interval, executing
+// each one the same way Run does, until ctx is cancelled. A failure in one test is
+// logged and reflected in its TestStats, but never stops the loop or
+// affects the other tests. Serve returns nil when ctx is cancelled.
+func Serve(ctx context.Context, interval time.Duration, tests []NamedTest, opts ...ServeOptions) error {
+	var opt ServeOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	logger := opt.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	var mu sync.Mutex
+	stats := make(map[string]*TestStats, len(tests))
+	for _, nt := range tests {
+		stats[nt.Name] = &TestStats{}
+	}
+
+	runOnce := func() {
+		for _, nt := range tests {
+			t := New(ctx, nt.Name)
+
+			start := time.Now()
+			runTB(t, nt.Fn)
+			duration := time.Since(start)
+
+			var err error
+			if t.Failed() {
+				err = fmt.Errorf("test failed:\n%s", t.Logs())
+			}
+
+			mu.Lock()
+			s := stats[nt.Name]
+			s.Runs++
+			s.LastDuration = duration
+			switch {
+			case t.Skipped():
+				s.Skips++
+			case err != nil:
+				s.Failures++
+				s.Healthy = false
+			default:
+				s.Successes++
+				s.Healthy = true
+			}
+			snapshot := *s
+			mu.Unlock()
+
+			if err != nil {
+				logger.Error("runt: test failed", "test", nt.Name, "error", err, "duration", duration)
+			}
+			if opt.Sink != nil {
+				opt.Sink.Report(nt.Name, snapshot)
+			}
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	runOnce()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			runOnce()
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package runt
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordingSink struct {
+	mu     sync.Mutex
+	latest map[string]TestStats
+}
+
+func newRecordingSink() *recordingSink {
+	return &recordingSink{latest: make(map[string]TestStats)}
+}
+
+func (s *recordingSink) Report(name string, stats TestStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latest[name] = stats
+}
+
+func (s *recordingSink) get(name string) TestStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.latest[name]
+}
+
+func TestServe_RunsUntilCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var runs int
+	var mu sync.Mutex
+
+	tests := []NamedTest{
+		{Name: "ok", Fn: func(tb testing.TB) {
+			mu.Lock()
+			runs++
+			mu.Unlock()
+		}},
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- Serve(ctx, 5*time.Millisecond, tests)
+	}()
+
+	time.Sleep(30 * time.Millisecond)
+	cancel()
+
+	if err := <-done; err != nil {
+		t.Errorf("Expected nil error from Serve after cancellation, got: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if runs < 2 {
+		t.Errorf("Expected the test to run more than once, ran: %d", runs)
+	}
+}
+
+func TestServe_FailureDoesNotStopLoop(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var runs int
+	var mu sync.Mutex
+
+	tests := []NamedTest{
+		{Name: "always-fails", Fn: func(tb testing.TB) {
+			mu.Lock()
+			runs++
+			mu.Unlock()
+			tb.Error("boom")
+		}},
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- Serve(ctx, 5*time.Millisecond, tests)
+	}()
+
+	time.Sleep(30 * time.Millisecond)
+	cancel()
+	<-done
+
+	mu.Lock()
+	defer mu.Unlock()
+	if runs < 2 {
+		t.Errorf("Expected a failing test to keep running, ran: %d", runs)
+	}
+}
+
+func TestServe_ReportsStatsToSink(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sink := newRecordingSink()
+
+	tests := []NamedTest{
+		{Name: "flaky", Fn: func(tb testing.TB) {
+			tb.Error("boom")
+		}},
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- Serve(ctx, 5*time.Millisecond, tests, ServeOptions{Sink: sink})
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	<-done
+
+	stats := sink.get("flaky")
+	if stats.Runs == 0 {
+		t.Fatal("Expected sink to have received at least one report")
+	}
+	if stats.Failures != stats.Runs {
+		t.Errorf("Expected all runs to be recorded as failures, got: %+v", stats)
+	}
+	if stats.Healthy {
+		t.Error("Expected Healthy to be false after a failing run")
+	}
+}
@@ -0,0 +1,177 @@
+package runt
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// TestResult captures the outcome of a single suite test method.
+type TestResult struct {
+	Name    string
+	Passed  bool
+	Skipped bool
+	Logs    string
+}
+
+// SuiteResult aggregates the outcome of every test method run by
+// RunSuite, in the order they were run.
+type SuiteResult struct {
+	Name  string
+	Tests []TestResult
+}
+
+// Failed reports whether any test in the suite failed.
+func (r SuiteResult) Failed() bool {
+	for _, tr := range r.Tests {
+		if !tr.Passed && !tr.Skipped {
+			return true
+		}
+	}
+	return false
+}
+
+// RunSuite discovers and runs fixture methods on s by reflection,
+// gocheck-style. s is typically a pointer to a suite struct, since
+// fixture and test methods are usually defined with a pointer
+// receiver. If present, SetUpSuite(t *T) runs once before any test and
+// TearDownSuite(t *T) once after all tests, even if SetUpSuite fails
+// or panics, so it can release whatever SetUpSuite already acquired.
+// SetUpTest(t *T)
+// and TearDownTest(t *T), if present, wrap every Test* method. A
+// failure in SetUpTest skips that test's body but TearDownTest still
+// runs; a panic in a test body does not prevent TearDownTest from
+// running. Test* methods run in alphabetical order. RunSuite returns a
+// SuiteResult describing every test it ran, alongside an error
+// summarizing any failures.
+func RunSuite(ctx context.Context, s any) (SuiteResult, error) {
+	v := reflect.ValueOf(s)
+
+	result := SuiteResult{Name: reflect.Indirect(v).Type().Name()}
+
+	setUpSuite, _ := suiteMethod(v, "SetUpSuite")
+	tearDownSuite, _ := suiteMethod(v, "TearDownSuite")
+	setUpTest, _ := suiteMethod(v, "SetUpTest")
+	tearDownTest, _ := suiteMethod(v, "TearDownTest")
+
+	if tearDownSuite != nil {
+		defer func() {
+			t := New(ctx, "TearDownSuite")
+			runTB(t, func(tb testing.TB) { tearDownSuite(t) })
+		}()
+	}
+
+	if setUpSuite != nil {
+		t := New(ctx, "SetUpSuite")
+		runTB(t, func(tb testing.TB) { setUpSuite(t) })
+		if t.Failed() {
+			return result, fmt.Errorf("SetUpSuite failed:\n%s", t.Logs())
+		}
+	}
+
+	for _, name := range suiteTestNames(v) {
+		test, _ := suiteMethod(v, name)
+		result.Tests = append(result.Tests, runSuiteTest(ctx, name, test, setUpTest, tearDownTest))
+	}
+
+	if result.Failed() {
+		return result, fmt.Errorf("suite %s: %d of %d test(s) failed", result.Name, countFailed(result.Tests), len(result.Tests))
+	}
+
+	return result, nil
+}
+
+// runSuiteTest runs a single Test* method, bracketed by SetUpTest and
+// TearDownTest if present.
+func runSuiteTest(ctx context.Context, name string, test, setUp, tearDown func(*T)) TestResult {
+	t := New(ctx, name)
+
+	runTB(t, func(tb testing.TB) {
+		if tearDown != nil {
+			defer tearDown(t)
+		}
+
+		setupOK := true
+		if setUp != nil {
+			setupOK = runSuiteFixture(t, "SetUpTest", setUp)
+		}
+
+		if setupOK {
+			test(t)
+		} else {
+			t.Log("skipping test body: SetUpTest failed")
+		}
+	})
+
+	return TestResult{
+		Name:    name,
+		Passed:  !t.Failed() && !t.Skipped(),
+		Skipped: t.Skipped(),
+		Logs:    t.Logs(),
+	}
+}
+
+// runSuiteFixture runs a SetUpTest fixture, recovering a Fatal/FailNow
+// panic or any other panic so that it only skips the test body rather
+// than aborting the whole suite. It returns false if the fixture failed.
+func runSuiteFixture(t *T, label string, fixture func(*T)) (ok bool) {
+	ok = true
+	defer func() {
+		x := recover()
+		switch x {
+		case nil:
+			ok = !t.Failed()
+		case testFailed{}, testSkipped{}:
+			ok = false
+		default:
+			t.Errorf("PANIC in %s: %v", label, x)
+			ok = false
+		}
+	}()
+	fixture(t)
+	return
+}
+
+// suiteMethod looks up a method by name on v and asserts it has the
+// fixture/test signature func(*T).
+func suiteMethod(v reflect.Value, name string) (func(*T), bool) {
+	m := v.MethodByName(name)
+	if !m.IsValid() {
+		return nil, false
+	}
+	fn, ok := m.Interface().(func(*T))
+	return fn, ok
+}
+
+// suiteTestNames returns the names of every Test* method on v with the
+// signature func(*T), sorted alphabetically.
+func suiteTestNames(v reflect.Value) []string {
+	t := v.Type()
+
+	var names []string
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		if !strings.HasPrefix(m.Name, "Test") {
+			continue
+		}
+		if _, ok := suiteMethod(v, m.Name); ok {
+			names = append(names, m.Name)
+		}
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+func countFailed(tests []TestResult) int {
+	n := 0
+	for _, tr := range tests {
+		if !tr.Passed && !tr.Skipped {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,145 @@
+package runt
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+type trackingSuite struct {
+	calls []string
+}
+
+func (s *trackingSuite) SetUpSuite(t *T)    { s.calls = append(s.calls, "SetUpSuite") }
+func (s *trackingSuite) TearDownSuite(t *T) { s.calls = append(s.calls, "TearDownSuite") }
+func (s *trackingSuite) SetUpTest(t *T)     { s.calls = append(s.calls, "SetUpTest:"+t.Name()) }
+func (s *trackingSuite) TearDownTest(t *T)  { s.calls = append(s.calls, "TearDownTest:"+t.Name()) }
+
+func (s *trackingSuite) TestA(t *T) { s.calls = append(s.calls, "TestA") }
+func (s *trackingSuite) TestB(t *T) { s.calls = append(s.calls, "TestB") }
+
+func TestRunSuite_FixtureOrdering(t *testing.T) {
+	ctx := context.Background()
+	s := &trackingSuite{}
+
+	result, err := RunSuite(ctx, s)
+	if err != nil {
+		t.Fatalf("Expected nil error, got: %v", err)
+	}
+	if len(result.Tests) != 2 {
+		t.Fatalf("Expected 2 test results, got: %d", len(result.Tests))
+	}
+
+	expected := []string{
+		"SetUpSuite",
+		"SetUpTest:TestA", "TestA", "TearDownTest:TestA",
+		"SetUpTest:TestB", "TestB", "TearDownTest:TestB",
+		"TearDownSuite",
+	}
+	if len(s.calls) != len(expected) {
+		t.Fatalf("Expected calls %v, got: %v", expected, s.calls)
+	}
+	for i, want := range expected {
+		if s.calls[i] != want {
+			t.Errorf("Expected call %d to be %q, got: %q (full: %v)", i, want, s.calls[i], s.calls)
+		}
+	}
+}
+
+type failingSetUpSuite struct {
+	calls []string
+}
+
+func (s *failingSetUpSuite) SetUpSuite(t *T)    { t.Fatal("nope") }
+func (s *failingSetUpSuite) TearDownSuite(t *T) { s.calls = append(s.calls, "TearDownSuite") }
+func (s *failingSetUpSuite) TestA(t *T)         { s.calls = append(s.calls, "TestA") }
+
+func TestRunSuite_SetUpSuiteFailureSkipsTests(t *testing.T) {
+	ctx := context.Background()
+	s := &failingSetUpSuite{}
+
+	result, err := RunSuite(ctx, s)
+	if err == nil {
+		t.Fatal("Expected error when SetUpSuite fails, got nil")
+	}
+	if len(result.Tests) != 0 {
+		t.Errorf("Expected no tests to run after SetUpSuite failure, got: %v", result.Tests)
+	}
+	if !reflect.DeepEqual(s.calls, []string{"TearDownSuite"}) {
+		t.Errorf("Expected TearDownSuite to still run (but not TestA), got: %v", s.calls)
+	}
+}
+
+type failingSetUpTest struct {
+	calls []string
+}
+
+func (s *failingSetUpTest) SetUpTest(t *T)    { t.Fatal("setup failed") }
+func (s *failingSetUpTest) TearDownTest(t *T) { s.calls = append(s.calls, "TearDownTest") }
+func (s *failingSetUpTest) TestA(t *T)        { s.calls = append(s.calls, "TestA") }
+
+func TestRunSuite_SetUpTestFailureSkipsBodyButRunsTeardown(t *testing.T) {
+	ctx := context.Background()
+	s := &failingSetUpTest{}
+
+	result, err := RunSuite(ctx, s)
+	if err == nil {
+		t.Fatal("Expected error when SetUpTest fails, got nil")
+	}
+	if len(result.Tests) != 1 || result.Tests[0].Passed {
+		t.Fatalf("Expected the one test to be recorded as failed, got: %v", result.Tests)
+	}
+
+	found := false
+	for _, c := range s.calls {
+		if c == "TestA" {
+			t.Error("Expected the test body not to run when SetUpTest fails")
+		}
+		if c == "TearDownTest" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected TearDownTest to run even though SetUpTest failed")
+	}
+}
+
+type panickingTest struct {
+	tornDown bool
+}
+
+func (s *panickingTest) TearDownTest(t *T) { s.tornDown = true }
+func (s *panickingTest) TestPanics(t *T)   { panic("boom") }
+
+func TestRunSuite_PanicInTestStillRunsTeardown(t *testing.T) {
+	ctx := context.Background()
+	s := &panickingTest{}
+
+	result, err := RunSuite(ctx, s)
+	if err == nil {
+		t.Fatal("Expected error when a test panics, got nil")
+	}
+	if !s.tornDown {
+		t.Error("Expected TearDownTest to run despite the test body panicking")
+	}
+	if len(result.Tests) != 1 || result.Tests[0].Passed {
+		t.Errorf("Expected the panicking test to be recorded as failed, got: %v", result.Tests)
+	}
+}
+
+type skippingTest struct{}
+
+func (s *skippingTest) TestSkipped(t *T) { t.Skip("not applicable") }
+
+func TestRunSuite_SkippedTestDoesNotFailSuite(t *testing.T) {
+	ctx := context.Background()
+	s := &skippingTest{}
+
+	result, err := RunSuite(ctx, s)
+	if err != nil {
+		t.Errorf("Expected nil error for a suite with only a skipped test, got: %v", err)
+	}
+	if len(result.Tests) != 1 || !result.Tests[0].Skipped {
+		t.Errorf("Expected the test to be recorded as skipped, got: %v", result.Tests)
+	}
+}
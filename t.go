@@ -8,9 +8,9 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"path/filepath"
 	"runtime/debug"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -21,25 +21,46 @@ import (
 // logging, and proper error handling with panics.
 type T struct {
 	testing.TB
-	name    string
-	ctx     context.Context
-	parent  *T
-	logs    *strings.Builder
-	failed  bool
-	skipped bool
+	name     string
+	ctx      context.Context
+	cancel   context.CancelFunc
+	parent   *T
+	logs     *strings.Builder
+	failed   bool
+	skipped  bool
+	mu       sync.Mutex
+	cleanups []func()
+
+	// parallel support; see parallel.go.
+	parallelism      int
+	sem              chan struct{}
+	parallelCalled   bool
+	parallelSignal   chan struct{}
+	finished         chan struct{}
+	acquiredSem      chan struct{}
+	parallelChildren []*T
+
+	// background goroutines; see goroutine.go.
+	wg sync.WaitGroup
+
+	// helper frame tracking; see helper.go.
+	helperPCs map[uintptr]struct{}
 }
 
 var _ testing.TB = (*T)(nil)
 
 // New creates a new test instance with the given context and name.
-// The context can be used to propagate cancellation and deadlines
-// throughout the test execution.
+// The context is derived with cancellation: it is cancelled as soon as
+// the test fails or is skipped, so that any background goroutines
+// started via (*T).Go shut down promptly.
 func New(ctx context.Context, name string) *T {
+	derived, cancel := context.WithCancel(ctx)
 	return &T{
-		TB:   nil, // unused, has to be here because private()
-		name: name,
-		ctx:  ctx,
-		logs: &strings.Builder{},
+		TB:     nil, // unused, has to be here because private()
+		name:   name,
+		ctx:    derived,
+		cancel: cancel,
+		logs:   &strings.Builder{},
 	}
 }
 
@@ -49,34 +70,78 @@ func (e *T) Name() string {
 }
 
 // Run executes a subtest with the given name and callback function.
-// It creates a new T instance for the subtest, handles panics gracefully,
-// and returns true if the subtest passed (did not fail or skip).
-// Any failure in the subtest will also mark the parent test as failed.
+// It creates a new T instance for the subtest, propagates the parent's
+// context, and handles panics gracefully.
+//
+// If the subtest calls (*T).Parallel, Run returns as soon as that call
+// is made (mirroring testing.T) rather than waiting for the subtest
+// body to finish; the parent collects the subtest and waits for it,
+// along with any other parallel siblings, before the enclosing Run call
+// returns. Otherwise Run blocks until the subtest completes and returns
+// true if it did not fail; a skipped subtest counts as passed. Any
+// failure in the subtest will also mark the parent test as failed.
 func (e *T) Run(name string, cb func(*T)) bool {
 	sub := New(e.ctx, name)
 	sub.parent = e
-	// capture test panics, from assertions, skips, or otherwise
-	defer func() {
-		x := recover()
-		switch x {
-		case nil:
-		case testSkipped{}, testFailed{}:
-		default:
-			sub.Errorf("PANIC: %v", x)
-			sub.Error(debug.Stack())
+	sub.parallelSignal = make(chan struct{}, 1)
+	sub.finished = make(chan struct{})
+
+	e.mu.Lock()
+	if len(e.helperPCs) > 0 {
+		sub.helperPCs = make(map[uintptr]struct{}, len(e.helperPCs))
+		for pc := range e.helperPCs {
+			sub.helperPCs[pc] = struct{}{}
 		}
+	}
+	e.mu.Unlock()
+
+	go func() {
+		defer sub.cancel()
+		defer close(sub.finished)
+		defer sub.releaseParallelSlot()
+		// capture test panics, from assertions, skips, or otherwise
+		defer func() {
+			x := recover()
+			switch x {
+			case nil:
+			case testSkipped{}, testFailed{}:
+			default:
+				sub.Errorf("PANIC: %v", x)
+				sub.Error(debug.Stack())
+			}
+		}()
+		defer sub.runCleanups()
+		defer sub.Wait()
+		defer sub.waitParallel()
+		cb(sub)
 	}()
-	cb(sub)
-	return !sub.Failed()
-}
 
-// Helper marks the calling function as a test helper function.
-// This implementation is a no-op for compatibility with testing.TB.
-func (e *T) Helper() {}
+	select {
+	case <-sub.finished:
+		// The subtest may have called Parallel and finished before this
+		// select ran; collect it anyway so its logs are aggregated.
+		select {
+		case <-sub.parallelSignal:
+			e.mu.Lock()
+			e.parallelChildren = append(e.parallelChildren, sub)
+			e.mu.Unlock()
+		default:
+		}
+		return !sub.Failed()
+	case <-sub.parallelSignal:
+		e.mu.Lock()
+		e.parallelChildren = append(e.parallelChildren, sub)
+		e.mu.Unlock()
+		return !sub.Failed()
+	}
+}
 
 // Logs returns all logged output from this test as a string.
 // This includes output from Log, Logf, Error, Errorf, Fatal, and Fatalf calls.
+// Safe to call concurrently.
 func (e *T) Logs() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
 	return e.logs.String()
 }
 
@@ -100,15 +165,33 @@ func (e *T) Errorf(format string, args ...any) {
 	e.Fail()
 }
 
-// Log logs the arguments to the test's log buffer.
+// Log logs the arguments to the test's log buffer, prefixed with the
+// file:line of the nearest caller that is neither marked as a Helper
+// nor one of runt's own logging methods (Log, Logf, Error, Errorf,
+// Fatal, Fatalf, Skip, Skipf). Other frames inside package runt are
+// not skipped, so a line runt logs on its own behalf (such as a
+// recovered panic) carries runt's own file:line.
 // Arguments are handled similar to fmt.Println.
+// Safe to call concurrently, e.g. from parallel subtests.
 func (e *T) Log(args ...any) {
+	prefix := e.callerPrefix()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	fmt.Fprint(e.logs, prefix)
 	fmt.Fprintln(e.logs, args...)
 }
 
-// Logf formats and logs the message to the test's log buffer.
+// Logf formats and logs the message to the test's log buffer, prefixed
+// with the file:line of the nearest caller, chosen the same way as for
+// Log: Helper-marked functions and runt's own logging methods are
+// skipped, other frames inside package runt are not.
 // Format and arguments are handled similar to fmt.Printf.
+// Safe to call concurrently, e.g. from parallel subtests.
 func (e *T) Logf(format string, args ...any) {
+	prefix := e.callerPrefix()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	fmt.Fprint(e.logs, prefix)
 	fmt.Fprintf(e.logs, format+"\n", args...)
 }
 
@@ -127,39 +210,54 @@ func (e *T) Fatalf(format string, args ...any) {
 }
 
 // Fail marks the test as failed but continues execution.
-// If this test has a parent (i.e., it's a subtest), the parent is also marked as failed.
+// If this test has a parent (i.e., it's a subtest), the parent is also
+// marked as failed, so a failure bubbles all the way up even when the
+// child is running as a parallel subtest on another goroutine. The
+// test's context is cancelled, so any background goroutines started
+// via (*T).Go shut down promptly. Safe to call concurrently.
 func (e *T) Fail() {
 	if e.parent != nil {
 		e.parent.Fail()
 	}
+	e.mu.Lock()
 	e.failed = true
+	e.mu.Unlock()
+	e.cancel()
 }
 
 type testFailed struct{}
 type testSkipped struct{}
 
-// FailNow marks the test as failed and stops execution immediately by panicking.
-// This will terminate the current test but can be recovered by Run method.
+// FailNow marks the test as failed and stops execution immediately by
+// panicking. This will terminate the current test but can be recovered
+// by Run method. The test's context is cancelled, so any background
+// goroutines started via (*T).Go shut down promptly.
 func (e *T) FailNow() {
-	e.failed = true
+	e.Fail()
 	panic(testFailed{})
 }
 
 // Failed returns true if the test has been marked as failed.
+// Safe to call concurrently.
 func (e *T) Failed() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
 	return e.failed
 }
 
-// TempDir creates and returns a temporary directory for the test.
-// The directory is created with a unique name based on the current timestamp.
-// If directory creation fails, the test is terminated with Fatal.
+// TempDir creates and returns a new temporary directory for the test,
+// using os.MkdirTemp to guarantee a collision-free name. The directory
+// and its contents are removed automatically via Cleanup once the test
+// completes. If directory creation fails, the test is terminated with
+// Fatal.
 func (e *T) TempDir() string {
-	// Create temporary directory for test
-	dir := filepath.Join(os.TempDir(), fmt.Sprintf("evalT-%d", time.Now().UnixNano()))
-	err := os.MkdirAll(dir, 0755)
+	dir, err := os.MkdirTemp("", "runt-")
 	if err != nil {
 		e.Fatal(err)
 	}
+	e.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
 	return dir
 }
 
@@ -172,17 +270,66 @@ func (e *T) Chdir(dir string) {
 	}
 }
 
-// Cleanup registers a cleanup function to be called when the test completes.
-// This implementation is a no-op for compatibility with testing.TB.
-func (e *T) Cleanup(func()) {}
+// Cleanup registers a function to be called when the test completes.
+// Cleanup functions are run in last-added-first-called (LIFO) order,
+// matching the behavior of testing.T. A panic inside a cleanup function
+// is recovered and recorded as a test failure rather than propagating.
+func (e *T) Cleanup(fn func()) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cleanups = append(e.cleanups, fn)
+}
+
+// runCleanups drains the registered cleanup functions in LIFO order.
+// It is called by Run (and (*T).Run for subtests) after the test
+// callback returns, including when it returns via panic.
+func (e *T) runCleanups() {
+	for {
+		e.mu.Lock()
+		n := len(e.cleanups)
+		if n == 0 {
+			e.mu.Unlock()
+			return
+		}
+		fn := e.cleanups[n-1]
+		e.cleanups = e.cleanups[:n-1]
+		e.mu.Unlock()
+
+		e.runCleanup(fn)
+	}
+}
+
+// runCleanup runs a single cleanup function, recovering any panic and
+// recording it as a test failure with its stack trace appended to the log.
+func (e *T) runCleanup(fn func()) {
+	defer func() {
+		if x := recover(); x != nil {
+			e.Errorf("cleanup panic: %v\n%s", x, debug.Stack())
+		}
+	}()
+	fn()
+}
 
-// Setenv sets an environment variable for the duration of the test.
-// If setting the environment variable fails, the test is terminated with Fatal.
+// Setenv sets an environment variable for the duration of the test and
+// registers a Cleanup that restores the variable to its prior value (or
+// unsets it, if it was not previously set), matching the testing.T
+// contract. If setting the environment variable fails, the test is
+// terminated with Fatal.
 func (e *T) Setenv(key, value string) {
+	prev, had := os.LookupEnv(key)
+
 	err := os.Setenv(key, value)
 	if err != nil {
 		e.Fatal(err)
 	}
+
+	e.Cleanup(func() {
+		if had {
+			os.Setenv(key, prev)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
 }
 
 // Skip logs the arguments and marks the test as skipped.
@@ -199,15 +346,23 @@ func (e *T) Skipf(format string, args ...any) {
 	e.SkipNow()
 }
 
-// SkipNow marks the test as skipped and stops execution immediately by panicking.
-// This will terminate the current test but can be recovered by Run method.
+// SkipNow marks the test as skipped and stops execution immediately by
+// panicking. This will terminate the current test but can be recovered
+// by Run method. The test's context is cancelled, so any background
+// goroutines started via (*T).Go shut down promptly.
 func (e *T) SkipNow() {
+	e.mu.Lock()
 	e.skipped = true
+	e.mu.Unlock()
+	e.cancel()
 	panic(testSkipped{})
 }
 
 // Skipped returns true if the test has been marked as skipped.
+// Safe to call concurrently.
 func (e *T) Skipped() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
 	return e.skipped
 }
 